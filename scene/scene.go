@@ -0,0 +1,203 @@
+// Package scene loads declarative YAML/JSON scene descriptions so users
+// can render new scenes without editing main.go.
+package scene
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cschladetsch/GoTracer/tracer"
+)
+
+// CameraSpec describes the camera the same way NewCamera does, minus the
+// up vector (always world-up) and aspect ratio (derived from Width/Height).
+type CameraSpec struct {
+	LookFrom  [3]float64 `yaml:"look_from" json:"look_from"`
+	LookAt    [3]float64 `yaml:"look_at" json:"look_at"`
+	VFov      float64    `yaml:"vfov" json:"vfov"`
+	Aperture  float64    `yaml:"aperture" json:"aperture"`
+	FocusDist float64    `yaml:"focus_dist" json:"focus_dist"`
+}
+
+// MaterialSpec describes one named entry of the scene's material
+// palette. Type selects which fields apply: "lambertian" and "metal"
+// use Albedo (Metal also uses Fuzz), "dielectric" uses IOR,
+// "diffuse_light" uses Emit, and "checkerboard" uses neither.
+type MaterialSpec struct {
+	Type   string     `yaml:"type" json:"type"`
+	Albedo [3]float64 `yaml:"albedo,omitempty" json:"albedo,omitempty"`
+	Fuzz   float64    `yaml:"fuzz,omitempty" json:"fuzz,omitempty"`
+	IOR    float64    `yaml:"ior,omitempty" json:"ior,omitempty"`
+	Emit   [3]float64 `yaml:"emit,omitempty" json:"emit,omitempty"`
+}
+
+// ObjectSpec describes one primitive in the scene. Type "sphere" uses
+// Center and Radius; type "plane" uses Corner and the U/V edge vectors
+// of the quad; type "mesh" uses Path, resolved relative to the scene
+// file's directory.
+type ObjectSpec struct {
+	Type     string     `yaml:"type" json:"type"`
+	Material string     `yaml:"material" json:"material"`
+	Center   [3]float64 `yaml:"center,omitempty" json:"center,omitempty"`
+	Radius   float64    `yaml:"radius,omitempty" json:"radius,omitempty"`
+	Corner   [3]float64 `yaml:"corner,omitempty" json:"corner,omitempty"`
+	U        [3]float64 `yaml:"u,omitempty" json:"u,omitempty"`
+	V        [3]float64 `yaml:"v,omitempty" json:"v,omitempty"`
+	Path     string     `yaml:"path,omitempty" json:"path,omitempty"`
+}
+
+// SceneFile is the on-disk scene description, decoded directly from YAML
+// or JSON.
+type SceneFile struct {
+	Width     int                     `yaml:"width,omitempty" json:"width,omitempty"`
+	Height    int                     `yaml:"height,omitempty" json:"height,omitempty"`
+	Samples   int                     `yaml:"samples,omitempty" json:"samples,omitempty"`
+	Bounces   int                     `yaml:"bounces,omitempty" json:"bounces,omitempty"`
+	Camera    CameraSpec              `yaml:"camera" json:"camera"`
+	Materials map[string]MaterialSpec `yaml:"materials" json:"materials"`
+	Objects   []ObjectSpec            `yaml:"objects" json:"objects"`
+}
+
+// Scene is a SceneFile resolved into renderer-ready types.
+type Scene struct {
+	Width, Height int
+	Samples       int
+	Bounces       int
+	Camera        *tracer.Camera
+	World         tracer.Hittable
+}
+
+// Load reads and validates a scene file, dispatching on its extension
+// (.yaml/.yml or .json), resolves its material references, and builds
+// the camera and world BVH.
+func Load(path string) (*Scene, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load scene %q: %w", path, err)
+	}
+
+	var sf SceneFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &sf); err != nil {
+			return nil, fmt.Errorf("load scene %q: %w", path, err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &sf); err != nil {
+			return nil, fmt.Errorf("load scene %q: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("load scene %q: unsupported extension %q", path, ext)
+	}
+
+	if len(sf.Objects) == 0 {
+		return nil, fmt.Errorf("load scene %q: scene has no objects", path)
+	}
+	if sf.Camera.VFov == 0 {
+		return nil, fmt.Errorf("load scene %q: camera.vfov is required", path)
+	}
+
+	materials := make(map[string]tracer.Material, len(sf.Materials))
+	for name, spec := range sf.Materials {
+		mat, err := buildMaterial(spec)
+		if err != nil {
+			return nil, fmt.Errorf("load scene %q: material %q: %w", path, name, err)
+		}
+		materials[name] = mat
+	}
+
+	var hittables []tracer.Hittable
+	for i, obj := range sf.Objects {
+		mat, ok := materials[obj.Material]
+		if !ok {
+			return nil, fmt.Errorf("load scene %q: object %d references unknown material %q", path, i, obj.Material)
+		}
+
+		switch obj.Type {
+		case "sphere":
+			hittables = append(hittables, &tracer.Sphere{
+				Center:   vec(obj.Center),
+				Radius:   obj.Radius,
+				Material: mat,
+			})
+		case "plane":
+			hittables = append(hittables, &tracer.Plane{
+				Corner:   vec(obj.Corner),
+				U:        vec(obj.U),
+				V:        vec(obj.V),
+				Material: mat,
+			})
+		case "mesh":
+			meshPath := obj.Path
+			if !filepath.IsAbs(meshPath) {
+				meshPath = filepath.Join(filepath.Dir(path), meshPath)
+			}
+			mesh, err := tracer.LoadOBJ(meshPath, mat)
+			if err != nil {
+				return nil, fmt.Errorf("load scene %q: object %d: %w", path, i, err)
+			}
+			hittables = append(hittables, mesh.Hittables()...)
+		default:
+			return nil, fmt.Errorf("load scene %q: object %d has unknown type %q", path, i, obj.Type)
+		}
+	}
+
+	width, height := sf.Width, sf.Height
+	if width == 0 {
+		width = 800
+	}
+	if height == 0 {
+		height = 600
+	}
+	samples := sf.Samples
+	if samples == 0 {
+		samples = 1
+	}
+	bounces := sf.Bounces
+	if bounces == 0 {
+		bounces = 10
+	}
+
+	lookFrom := vec(sf.Camera.LookFrom)
+	lookAt := vec(sf.Camera.LookAt)
+	focusDist := sf.Camera.FocusDist
+	if focusDist == 0 {
+		focusDist = lookFrom.Sub(lookAt).Length()
+	}
+	camera := tracer.NewCamera(lookFrom, lookAt, tracer.Vec3{X: 0, Y: 1, Z: 0}, sf.Camera.VFov, float64(width)/float64(height), sf.Camera.Aperture, focusDist)
+
+	return &Scene{
+		Width:   width,
+		Height:  height,
+		Samples: samples,
+		Bounces: bounces,
+		Camera:  camera,
+		World:   tracer.NewBVH(hittables),
+	}, nil
+}
+
+func buildMaterial(spec MaterialSpec) (tracer.Material, error) {
+	switch spec.Type {
+	case "lambertian":
+		return tracer.Lambertian{Albedo: vec(spec.Albedo)}, nil
+	case "metal":
+		return tracer.Metal{Albedo: vec(spec.Albedo), Fuzz: spec.Fuzz}, nil
+	case "dielectric":
+		return tracer.Dielectric{IOR: spec.IOR}, nil
+	case "checkerboard":
+		return tracer.Checkerboard{}, nil
+	case "diffuse_light":
+		return tracer.DiffuseLight{Emit: vec(spec.Emit)}, nil
+	default:
+		return nil, fmt.Errorf("unknown material type %q", spec.Type)
+	}
+}
+
+func vec(v [3]float64) tracer.Vec3 {
+	return tracer.Vec3{X: v[0], Y: v[1], Z: v[2]}
+}
@@ -0,0 +1,44 @@
+package tracer
+
+// AABB is an axis-aligned bounding box used to accelerate ray intersection
+// tests in the BVH.
+type AABB struct {
+	Min, Max Vec3
+}
+
+// Hit performs the slab test, returning whether the ray passes through the
+// box within [tMin, tMax].
+func (b AABB) Hit(ray Ray, tMin, tMax float64) bool {
+	for axis := 0; axis < 3; axis++ {
+		invD := 1.0 / ray.Direction.Component(axis)
+		t0 := (b.Min.Component(axis) - ray.Origin.Component(axis)) * invD
+		t1 := (b.Max.Component(axis) - ray.Origin.Component(axis)) * invD
+		if invD < 0 {
+			t0, t1 = t1, t0
+		}
+		if t0 > tMin {
+			tMin = t0
+		}
+		if t1 < tMax {
+			tMax = t1
+		}
+		if tMax <= tMin {
+			return false
+		}
+	}
+	return true
+}
+
+// Centroid returns the midpoint of the box, used when sorting primitives
+// for BVH construction.
+func (b AABB) Centroid() Vec3 {
+	return b.Min.Add(b.Max).Mul(0.5)
+}
+
+// SurroundingBox returns the smallest AABB containing both a and b.
+func SurroundingBox(a, b AABB) AABB {
+	return AABB{
+		Min: a.Min.Min(b.Min),
+		Max: a.Max.Max(b.Max),
+	}
+}
@@ -0,0 +1,92 @@
+package tracer
+
+import "sort"
+
+// BVHNode is an interior node of a bounding volume hierarchy built
+// top-down over a scene's primitives. Leaves are the primitives
+// themselves (Sphere, Triangle, ...), reached once a split has only one
+// object left on a side.
+type BVHNode struct {
+	Left, Right Hittable
+	Box         AABB
+}
+
+// NewBVH builds a BVH over objects by recursively splitting along the
+// longest axis of the parent's centroid bounds and dividing at the
+// median, which keeps the tree balanced without needing per-split cost
+// evaluation.
+func NewBVH(objects []Hittable) Hittable {
+	switch len(objects) {
+	case 0:
+		return HittableList(nil)
+	case 1:
+		return objects[0]
+	}
+
+	axis := longestAxis(objects)
+	sorted := make([]Hittable, len(objects))
+	copy(sorted, objects)
+	sort.Slice(sorted, func(i, j int) bool {
+		bi, _ := sorted[i].BoundingBox()
+		bj, _ := sorted[j].BoundingBox()
+		return bi.Centroid().Component(axis) < bj.Centroid().Component(axis)
+	})
+
+	mid := len(sorted) / 2
+	left := NewBVH(sorted[:mid])
+	right := NewBVH(sorted[mid:])
+
+	leftBox, _ := left.BoundingBox()
+	rightBox, _ := right.BoundingBox()
+
+	return &BVHNode{Left: left, Right: right, Box: SurroundingBox(leftBox, rightBox)}
+}
+
+// longestAxis picks the axis (0=X, 1=Y, 2=Z) along which the centroids of
+// objects span the greatest extent.
+func longestAxis(objects []Hittable) int {
+	min, _ := objects[0].BoundingBox()
+	max := min
+	for _, obj := range objects[1:] {
+		box, _ := obj.BoundingBox()
+		c := box.Centroid()
+		min.Min = min.Min.Min(c)
+		max.Max = max.Max.Max(c)
+	}
+
+	extent := max.Max.Sub(min.Min)
+	axis := 0
+	largest := extent.X
+	if extent.Y > largest {
+		axis, largest = 1, extent.Y
+	}
+	if extent.Z > largest {
+		axis = 2
+	}
+	return axis
+}
+
+func (n *BVHNode) Hit(ray Ray, tMin, tMax float64) (HitRecord, bool) {
+	if !n.Box.Hit(ray, tMin, tMax) {
+		return HitRecord{}, false
+	}
+
+	leftRec, hitLeft := n.Left.Hit(ray, tMin, tMax)
+	closest := tMax
+	if hitLeft {
+		closest = leftRec.T
+	}
+
+	rightRec, hitRight := n.Right.Hit(ray, tMin, closest)
+	if hitRight {
+		return rightRec, true
+	}
+	if hitLeft {
+		return leftRec, true
+	}
+	return HitRecord{}, false
+}
+
+func (n *BVHNode) BoundingBox() (AABB, bool) {
+	return n.Box, true
+}
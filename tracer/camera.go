@@ -0,0 +1,73 @@
+package tracer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Camera models a positionable, defocus-blur-capable pinhole camera. The
+// view basis (u, v, w) and viewport corners are precomputed once so that
+// GetRay is just a cheap lerp plus an optional lens-disk jitter.
+type Camera struct {
+	Origin          Vec3
+	LowerLeftCorner Vec3
+	Horizontal      Vec3
+	Vertical        Vec3
+	U, V, W         Vec3
+	LensRadius      float64
+}
+
+// NewCamera builds a Camera looking from lookFrom towards lookAt, with
+// vUp defining the roll. vfov is the vertical field of view in degrees,
+// aperture is the lens diameter (0 disables defocus blur), and
+// focusDist is the distance to the plane that is in perfect focus.
+func NewCamera(lookFrom, lookAt, vUp Vec3, vfov, aspect, aperture, focusDist float64) *Camera {
+	theta := vfov * math.Pi / 180
+	h := math.Tan(theta / 2)
+	viewportHeight := 2.0 * h
+	viewportWidth := aspect * viewportHeight
+
+	w := lookFrom.Sub(lookAt).Normalize()
+	u := vUp.Cross(w).Normalize()
+	v := w.Cross(u)
+
+	origin := lookFrom
+	horizontal := u.Mul(viewportWidth * focusDist)
+	vertical := v.Mul(viewportHeight * focusDist)
+	lowerLeftCorner := origin.Sub(horizontal.Mul(0.5)).Sub(vertical.Mul(0.5)).Sub(w.Mul(focusDist))
+
+	return &Camera{
+		Origin:          origin,
+		LowerLeftCorner: lowerLeftCorner,
+		Horizontal:      horizontal,
+		Vertical:        vertical,
+		U:               u,
+		V:               v,
+		W:               w,
+		LensRadius:      aperture / 2,
+	}
+}
+
+// RandomInUnitDisk returns a random point within a unit-radius disk in the
+// XY plane, found by rejection sampling.
+func RandomInUnitDisk() Vec3 {
+	for {
+		p := Vec3{rand.Float64()*2 - 1, rand.Float64()*2 - 1, 0}
+		if p.Dot(p) < 1 {
+			return p
+		}
+	}
+}
+
+// GetRay returns the ray through viewport coordinates (s, t), where both
+// range over [0, 1]. When LensRadius is nonzero, the origin is jittered
+// over the lens disk to produce depth-of-field blur.
+func (c *Camera) GetRay(s, t float64) Ray {
+	rd := RandomInUnitDisk().Mul(c.LensRadius)
+	offset := c.U.Mul(rd.X).Add(c.V.Mul(rd.Y))
+
+	origin := c.Origin.Add(offset)
+	target := c.LowerLeftCorner.Add(c.Horizontal.Mul(s)).Add(c.Vertical.Mul(t))
+
+	return Ray{Origin: origin, Direction: target.Sub(origin).Normalize()}
+}
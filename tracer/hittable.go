@@ -0,0 +1,60 @@
+package tracer
+
+// HitRecord describes the surface properties at the point a ray intersects
+// a Hittable.
+type HitRecord struct {
+	T         float64
+	Point     Vec3
+	Normal    Vec3
+	FrontFace bool
+	Material  Material
+}
+
+// Hittable is implemented by anything a ray can intersect: spheres,
+// triangles, and BVH nodes that aggregate them.
+type Hittable interface {
+	Hit(ray Ray, tMin, tMax float64) (HitRecord, bool)
+	BoundingBox() (AABB, bool)
+}
+
+// HittableList is a flat, unaccelerated collection of Hittables. It is kept
+// around mainly as the input to BVH construction and as a fallback for
+// scenes too small to bother splitting.
+type HittableList []Hittable
+
+func (l HittableList) Hit(ray Ray, tMin, tMax float64) (HitRecord, bool) {
+	var best HitRecord
+	hitAnything := false
+	closest := tMax
+
+	for _, h := range l {
+		if rec, ok := h.Hit(ray, tMin, closest); ok {
+			hitAnything = true
+			closest = rec.T
+			best = rec
+		}
+	}
+
+	return best, hitAnything
+}
+
+func (l HittableList) BoundingBox() (AABB, bool) {
+	if len(l) == 0 {
+		return AABB{}, false
+	}
+
+	box, ok := l[0].BoundingBox()
+	if !ok {
+		return AABB{}, false
+	}
+
+	for _, h := range l[1:] {
+		b, ok := h.BoundingBox()
+		if !ok {
+			return AABB{}, false
+		}
+		box = SurroundingBox(box, b)
+	}
+
+	return box, true
+}
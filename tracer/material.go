@@ -0,0 +1,149 @@
+package tracer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Material is implemented by anything a ray can scatter off. Scatter
+// returns how much of the incoming light survives (attenuation) and the
+// ray the path continues along, or ok=false if the ray is absorbed.
+// Emitted returns the light a surface emits on its own, independent of
+// any incoming ray; non-emissive materials return the zero vector.
+type Material interface {
+	Scatter(rayIn Ray, hit HitRecord) (attenuation Vec3, scattered Ray, ok bool)
+	Emitted() Vec3
+}
+
+// Lambertian is a matte, diffuse material that scatters towards a
+// cosine-weighted random direction around the surface normal.
+type Lambertian struct {
+	Albedo Vec3
+}
+
+func (m Lambertian) Scatter(rayIn Ray, hit HitRecord) (Vec3, Ray, bool) {
+	scatterDir := hit.Normal.Add(RandomUnitVector())
+	if nearZero(scatterDir) {
+		scatterDir = hit.Normal
+	}
+	scattered := Ray{Origin: hit.Point.Add(hit.Normal.Mul(0.001)), Direction: scatterDir.Normalize()}
+	return m.Albedo, scattered, true
+}
+
+func (m Lambertian) Emitted() Vec3 {
+	return Vec3{0, 0, 0}
+}
+
+// Metal is a reflective material. Fuzz perturbs the reflected direction
+// within a sphere of that radius, producing a brushed/glossy look at
+// higher values; rays that would scatter below the surface are absorbed.
+type Metal struct {
+	Albedo Vec3
+	Fuzz   float64
+}
+
+func (m Metal) Scatter(rayIn Ray, hit HitRecord) (Vec3, Ray, bool) {
+	reflected := Reflect(rayIn.Direction.Normalize(), hit.Normal)
+	scatterDir := reflected.Add(RandomInUnitSphere().Mul(m.Fuzz))
+	scattered := Ray{Origin: hit.Point.Add(hit.Normal.Mul(0.001)), Direction: scatterDir.Normalize()}
+	return m.Albedo, scattered, scattered.Direction.Dot(hit.Normal) > 0
+}
+
+func (m Metal) Emitted() Vec3 {
+	return Vec3{0, 0, 0}
+}
+
+// Dielectric is a refractive material such as glass or water, with IOR
+// the index of refraction (e.g. 1.5 for glass). It always scatters,
+// probabilistically choosing reflection over refraction near grazing
+// angles via Schlick's approximation.
+type Dielectric struct {
+	IOR float64
+}
+
+func (m Dielectric) Scatter(rayIn Ray, hit HitRecord) (Vec3, Ray, bool) {
+	attenuation := Vec3{1, 1, 1}
+
+	refractionRatio := m.IOR
+	if hit.FrontFace {
+		refractionRatio = 1.0 / m.IOR
+	}
+
+	unitDir := rayIn.Direction.Normalize()
+	cosTheta := math.Min(unitDir.Mul(-1).Dot(hit.Normal), 1.0)
+	sinTheta := math.Sqrt(1.0 - cosTheta*cosTheta)
+
+	var direction Vec3
+	if refractionRatio*sinTheta > 1.0 || schlick(cosTheta, refractionRatio) > rand.Float64() {
+		direction = Reflect(unitDir, hit.Normal)
+	} else {
+		direction = Refract(unitDir, hit.Normal, refractionRatio)
+	}
+
+	scattered := Ray{Origin: hit.Point.Add(direction.Mul(0.001)), Direction: direction}
+	return attenuation, scattered, true
+}
+
+func (m Dielectric) Emitted() Vec3 {
+	return Vec3{0, 0, 0}
+}
+
+// schlick is Schlick's approximation for the Fresnel reflectance of a
+// dielectric surface: r0 + (1-r0)*(1-cosTheta)^5, where
+// r0 = ((1-eta)/(1+eta))^2.
+func schlick(cosine, refIdx float64) float64 {
+	r0 := (1 - refIdx) / (1 + refIdx)
+	r0 = r0 * r0
+	return r0 + (1-r0)*math.Pow(1-cosine, 5)
+}
+
+// Refract bends uv through a surface with normal n using Snell's law,
+// given the ratio of the incident to transmitted indices of refraction.
+func Refract(uv, n Vec3, etaiOverEtat float64) Vec3 {
+	cosTheta := math.Min(uv.Mul(-1).Dot(n), 1.0)
+	rOutPerp := uv.Add(n.Mul(cosTheta)).Mul(etaiOverEtat)
+	rOutParallel := n.Mul(-math.Sqrt(math.Abs(1.0 - rOutPerp.Dot(rOutPerp))))
+	return rOutPerp.Add(rOutParallel)
+}
+
+// Checkerboard is a Lambertian-like material whose albedo varies with
+// world-space position, used for the ground plane.
+type Checkerboard struct{}
+
+func (m Checkerboard) Scatter(rayIn Ray, hit HitRecord) (Vec3, Ray, bool) {
+	scatterDir := hit.Normal.Add(RandomUnitVector())
+	if nearZero(scatterDir) {
+		scatterDir = hit.Normal
+	}
+	scattered := Ray{Origin: hit.Point.Add(hit.Normal.Mul(0.001)), Direction: scatterDir.Normalize()}
+	return CheckerboardPattern(hit.Point), scattered, true
+}
+
+func (m Checkerboard) Emitted() Vec3 {
+	return Vec3{0, 0, 0}
+}
+
+// DiffuseLight is a one-sided emissive material used for area lights: it
+// absorbs every ray that hits it (Scatter always reports ok=false) while
+// radiating Emit regardless of the incoming ray.
+type DiffuseLight struct {
+	Emit Vec3
+}
+
+func (m DiffuseLight) Scatter(rayIn Ray, hit HitRecord) (Vec3, Ray, bool) {
+	return Vec3{}, Ray{}, false
+}
+
+func (m DiffuseLight) Emitted() Vec3 {
+	return m.Emit
+}
+
+// RandomUnitVector returns a uniformly random point on the unit sphere.
+func RandomUnitVector() Vec3 {
+	return RandomInUnitSphere().Normalize()
+}
+
+func nearZero(v Vec3) bool {
+	const eps = 1e-8
+	return math.Abs(v.X) < eps && math.Abs(v.Y) < eps && math.Abs(v.Z) < eps
+}
@@ -0,0 +1,17 @@
+package tracer
+
+// Mesh is a triangulated Wavefront OBJ model, loaded once and then exposed
+// as a slice of Hittable triangles for BVH construction.
+type Mesh struct {
+	Triangles []Triangle
+}
+
+// Hittables returns the mesh's triangles as Hittable primitives, suitable
+// for feeding into NewBVH alongside any spheres in the scene.
+func (m *Mesh) Hittables() []Hittable {
+	hittables := make([]Hittable, len(m.Triangles))
+	for i := range m.Triangles {
+		hittables[i] = &m.Triangles[i]
+	}
+	return hittables
+}
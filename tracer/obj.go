@@ -0,0 +1,135 @@
+package tracer
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LoadOBJ parses a Wavefront .obj file into a Mesh, triangulating any
+// polygonal faces with more than three vertices via fan triangulation.
+// Faces without normals get a flat normal derived from the winding order.
+func LoadOBJ(path string, material Material) (*Mesh, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("load obj %q: %w", path, err)
+	}
+	defer f.Close()
+
+	var vertices []Vec3
+	var normals []Vec3
+	var triangles []Triangle
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "v":
+			v, err := parseVec3(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("load obj %q: %w", path, err)
+			}
+			vertices = append(vertices, v)
+		case "vn":
+			n, err := parseVec3(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("load obj %q: %w", path, err)
+			}
+			normals = append(normals, n)
+		case "f":
+			faceVerts, faceNorms, err := parseFace(fields[1:], vertices, normals)
+			if err != nil {
+				return nil, fmt.Errorf("load obj %q: %w", path, err)
+			}
+			// Fan-triangulate polygons with more than 3 vertices.
+			for i := 1; i+1 < len(faceVerts); i++ {
+				v0, v1, v2 := faceVerts[0], faceVerts[i], faceVerts[i+1]
+				n0, n1, n2 := faceNorms[0], faceNorms[i], faceNorms[i+1]
+				if n0 == (Vec3{}) && n1 == (Vec3{}) && n2 == (Vec3{}) {
+					flat := v1.Sub(v0).Cross(v2.Sub(v0)).Normalize()
+					n0, n1, n2 = flat, flat, flat
+				}
+				triangles = append(triangles, Triangle{
+					V0: v0, V1: v1, V2: v2,
+					N0: n0, N1: n1, N2: n2,
+					Material: material,
+				})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("load obj %q: %w", path, err)
+	}
+
+	return &Mesh{Triangles: triangles}, nil
+}
+
+func parseVec3(fields []string) (Vec3, error) {
+	if len(fields) < 3 {
+		return Vec3{}, fmt.Errorf("expected 3 components, got %d", len(fields))
+	}
+	x, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return Vec3{}, err
+	}
+	y, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return Vec3{}, err
+	}
+	z, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return Vec3{}, err
+	}
+	return Vec3{x, y, z}, nil
+}
+
+// parseFace resolves a face's vertex/normal indices, supporting the
+// "v", "v/vt", "v/vt/vn", and "v//vn" index forms.
+func parseFace(fields []string, vertices, normals []Vec3) ([]Vec3, []Vec3, error) {
+	verts := make([]Vec3, len(fields))
+	norms := make([]Vec3, len(fields))
+
+	for i, field := range fields {
+		parts := strings.Split(field, "/")
+
+		vi, err := faceIndex(parts[0], len(vertices))
+		if err != nil {
+			return nil, nil, err
+		}
+		verts[i] = vertices[vi]
+
+		if len(parts) == 3 && parts[2] != "" {
+			ni, err := faceIndex(parts[2], len(normals))
+			if err != nil {
+				return nil, nil, err
+			}
+			norms[i] = normals[ni]
+		}
+	}
+
+	return verts, norms, nil
+}
+
+// faceIndex converts a 1-based (or negative, relative-to-end) OBJ index
+// into a 0-based slice index.
+func faceIndex(s string, count int) (int, error) {
+	idx, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad index %q: %w", s, err)
+	}
+	if idx < 0 {
+		idx = count + idx
+	} else {
+		idx--
+	}
+	if idx < 0 || idx >= count {
+		return 0, fmt.Errorf("index %d out of range [0,%d)", idx, count)
+	}
+	return idx, nil
+}
@@ -0,0 +1,80 @@
+package tracer
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/image/bmp"
+)
+
+// buildImage converts the renderer's linear Vec3 framebuffer into a
+// standard image.RGBA, clamping each channel to [0, 255].
+func buildImage(width, height int, pixels []Vec3) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			p := pixels[y*width+x]
+			img.SetRGBA(x, y, color.RGBA{
+				R: uint8(math.Min(255, p.X*255)),
+				G: uint8(math.Min(255, p.Y*255)),
+				B: uint8(math.Min(255, p.Z*255)),
+				A: 255,
+			})
+		}
+	}
+	return img
+}
+
+// SaveImage writes pixels to path, picking an encoder from the file
+// extension: .png, .jpg/.jpeg (at the given quality), .ppm (binary P6),
+// or .bmp.
+func SaveImage(path string, width, height int, pixels []Vec3, quality int) error {
+	img := buildImage(width, height, pixels)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png":
+		return png.Encode(f, img)
+	case ".jpg", ".jpeg":
+		return jpeg.Encode(f, img, &jpeg.Options{Quality: quality})
+	case ".ppm":
+		return writePPM(f, img)
+	case ".bmp":
+		return bmp.Encode(f, img)
+	default:
+		return fmt.Errorf("unsupported output extension %q", filepath.Ext(path))
+	}
+}
+
+// writePPM writes img as a binary (P6) PPM.
+func writePPM(f *os.File, img *image.RGBA) error {
+	bounds := img.Bounds()
+	if _, err := fmt.Fprintf(f, "P6\n%d %d\n255\n", bounds.Dx(), bounds.Dy()); err != nil {
+		return err
+	}
+
+	row := make([]byte, 0, bounds.Dx()*3)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row = row[:0]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			row = append(row, byte(r>>8), byte(g>>8), byte(b>>8))
+		}
+		if _, err := f.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
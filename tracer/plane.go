@@ -0,0 +1,72 @@
+package tracer
+
+import "math"
+
+const planeEpsilon = 1e-8
+
+// Plane is a finite parallelogram quad spanned by two edge vectors from
+// Corner, e.g. a wall or a rectangular area light. Unlike an infinite
+// mathematical plane, it has a well-defined AABB and so can sit directly
+// in a BVH alongside spheres and triangles.
+type Plane struct {
+	Corner   Vec3
+	U, V     Vec3
+	Material Material
+}
+
+// Hit solves the ray/plane intersection, then checks the hit point falls
+// within the parallelogram by expressing it in the (U, V) basis.
+func (p *Plane) Hit(ray Ray, tMin, tMax float64) (HitRecord, bool) {
+	normal := p.U.Cross(p.V)
+	denom := ray.Direction.Dot(normal)
+	if math.Abs(denom) < planeEpsilon {
+		return HitRecord{}, false
+	}
+
+	hitT := p.Corner.Sub(ray.Origin).Dot(normal) / denom
+	if hitT < tMin || hitT > tMax {
+		return HitRecord{}, false
+	}
+
+	point := ray.At(hitT)
+	hitVec := point.Sub(p.Corner)
+
+	uu := p.U.Dot(p.U)
+	vv := p.V.Dot(p.V)
+	uv := p.U.Dot(p.V)
+	wu := hitVec.Dot(p.U)
+	wv := hitVec.Dot(p.V)
+	det := uu*vv - uv*uv
+
+	u := (wu*vv - wv*uv) / det
+	v := (wv*uu - wu*uv) / det
+	if u < 0 || u > 1 || v < 0 || v > 1 {
+		return HitRecord{}, false
+	}
+
+	outwardNormal := normal.Normalize()
+	frontFace := ray.Direction.Dot(outwardNormal) < 0
+	faceNormal := outwardNormal
+	if !frontFace {
+		faceNormal = outwardNormal.Mul(-1)
+	}
+
+	return HitRecord{T: hitT, Point: point, Normal: faceNormal, FrontFace: frontFace, Material: p.Material}, true
+}
+
+// BoundingBox returns the box spanned by the quad's four corners, padded
+// along the degenerate (normal) axis so BVH slab tests never see a
+// zero-thickness box.
+func (p *Plane) BoundingBox() (AABB, bool) {
+	c0 := p.Corner
+	c1 := p.Corner.Add(p.U)
+	c2 := p.Corner.Add(p.V)
+	c3 := p.Corner.Add(p.U).Add(p.V)
+
+	min := c0.Min(c1).Min(c2).Min(c3)
+	max := c0.Max(c1).Max(c2).Max(c3)
+
+	const pad = 1e-4
+	padding := Vec3{pad, pad, pad}
+	return AABB{Min: min.Sub(padding), Max: max.Add(padding)}, true
+}
@@ -0,0 +1,10 @@
+package tracer
+
+type Ray struct {
+	Origin, Direction Vec3
+}
+
+// At returns the point reached by travelling distance t along the ray.
+func (r Ray) At(t float64) Vec3 {
+	return r.Origin.Add(r.Direction.Mul(t))
+}
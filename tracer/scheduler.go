@@ -0,0 +1,113 @@
+package tracer
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const tileSize = 32
+
+// Tile is a rectangular region of the image, in pixel coordinates, handed
+// to a worker goroutine as one unit of work.
+type Tile struct {
+	X0, Y0, X1, Y1 int
+}
+
+// tilesFor partitions a width x height image into tileSize x tileSize
+// tiles, clamped at the image edges.
+func tilesFor(width, height int) []Tile {
+	var tiles []Tile
+	for y := 0; y < height; y += tileSize {
+		for x := 0; x < width; x += tileSize {
+			tiles = append(tiles, Tile{
+				X0: x, Y0: y,
+				X1: min(x+tileSize, width),
+				Y1: min(y+tileSize, height),
+			})
+		}
+	}
+	return tiles
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// RenderImage renders the scene into a width x height image using a tile
+// work-stealing scheduler: tiles are pushed onto a buffered channel and
+// runtime.NumCPU() workers pull from it until the channel is drained,
+// rendering each tile into a local region of img directly (each tile
+// owns disjoint pixels, so no further synchronization is needed). A
+// reporter goroutine prints progress once a second while workers run.
+func RenderImage(width, height int, camera *Camera, world Hittable, background Background, maxDepth, samples int) []Vec3 {
+	img := make([]Vec3, width*height)
+	tiles := tilesFor(width, height)
+
+	tileChan := make(chan Tile, len(tiles))
+	for _, t := range tiles {
+		tileChan <- t
+	}
+	close(tileChan)
+
+	var completed int64
+	total := int64(len(tiles))
+
+	var wg sync.WaitGroup
+	workers := runtime.NumCPU()
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for tile := range tileChan {
+				renderTile(img, width, height, tile, camera, world, background, maxDepth, samples)
+				atomic.AddInt64(&completed, 1)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go reportProgress(&completed, total, done)
+
+	wg.Wait()
+	close(done)
+
+	return img
+}
+
+func renderTile(img []Vec3, width, height int, tile Tile, camera *Camera, world Hittable, background Background, maxDepth, samples int) {
+	for y := tile.Y0; y < tile.Y1; y++ {
+		for x := tile.X0; x < tile.X1; x++ {
+			img[y*width+x] = RenderPixel(x, y, width, height, camera, world, background, maxDepth, samples)
+		}
+	}
+}
+
+// reportProgress prints percent-complete and estimated time remaining
+// once a second until done is closed.
+func reportProgress(completed *int64, total int64, done chan struct{}) {
+	start := time.Now()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c := atomic.LoadInt64(completed)
+			if c == 0 {
+				continue
+			}
+			elapsed := time.Since(start)
+			rate := float64(c) / elapsed.Seconds()
+			eta := float64(total-c) / rate
+			fmt.Printf("Rendering: %.1f%% (ETA %.0fs)\n", 100*float64(c)/float64(total), eta)
+		}
+	}
+}
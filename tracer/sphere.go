@@ -0,0 +1,63 @@
+package tracer
+
+import "math"
+
+type Sphere struct {
+	Center   Vec3
+	Radius   float64
+	Material Material
+}
+
+func (s *Sphere) Intersect(ray Ray) (float64, bool) {
+	return s.intersectWithin(ray, 0, math.Inf(1))
+}
+
+// intersectWithin solves both roots of the sphere quadratic and returns
+// the smallest one within [tMin, tMax]. Solving only the near root would
+// miss rays that originate inside the sphere (e.g. a ray refracted into
+// a Dielectric sphere looking for the far wall to exit through).
+func (s *Sphere) intersectWithin(ray Ray, tMin, tMax float64) (float64, bool) {
+	oc := ray.Origin.Sub(s.Center)
+	a := ray.Direction.Dot(ray.Direction)
+	b := 2.0 * oc.Dot(ray.Direction)
+	c := oc.Dot(oc) - s.Radius*s.Radius
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	sqrtD := math.Sqrt(discriminant)
+	near := (-b - sqrtD) / (2.0 * a)
+	if near >= tMin && near <= tMax {
+		return near, true
+	}
+
+	far := (-b + sqrtD) / (2.0 * a)
+	if far >= tMin && far <= tMax {
+		return far, true
+	}
+
+	return 0, false
+}
+
+func (s *Sphere) Hit(ray Ray, tMin, tMax float64) (HitRecord, bool) {
+	t, hit := s.intersectWithin(ray, tMin, tMax)
+	if !hit {
+		return HitRecord{}, false
+	}
+
+	point := ray.At(t)
+	outwardNormal := point.Sub(s.Center).Normalize()
+	frontFace := ray.Direction.Dot(outwardNormal) < 0
+	normal := outwardNormal
+	if !frontFace {
+		normal = outwardNormal.Mul(-1)
+	}
+
+	return HitRecord{T: t, Point: point, Normal: normal, FrontFace: frontFace, Material: s.Material}, true
+}
+
+func (s *Sphere) BoundingBox() (AABB, bool) {
+	radius := Vec3{s.Radius, s.Radius, s.Radius}
+	return AABB{Min: s.Center.Sub(radius), Max: s.Center.Add(radius)}, true
+}
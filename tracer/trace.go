@@ -0,0 +1,94 @@
+package tracer
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Background computes the color of a ray that escapes the scene without
+// hitting anything, e.g. a sky gradient.
+type Background func(ray Ray) Vec3
+
+// DefaultSky is the flat sky color used by the original sphere demo.
+func DefaultSky(ray Ray) Vec3 {
+	return Vec3{0.2, 0.7, 0.8}
+}
+
+func Reflect(v, n Vec3) Vec3 {
+	return v.Sub(n.Mul(2 * v.Dot(n)))
+}
+
+func RandomInUnitSphere() Vec3 {
+	for {
+		p := Vec3{rand.Float64()*2 - 1, rand.Float64()*2 - 1, rand.Float64()*2 - 1}
+		if p.Dot(p) < 1 {
+			return p
+		}
+	}
+}
+
+func CheckerboardPattern(p Vec3) Vec3 {
+	if (int(math.Floor(p.X))+int(math.Floor(p.Z)))%2 == 0 {
+		return Vec3{0.1, 0.1, 0.1}
+	}
+	return Vec3{0.9, 0.9, 0.9}
+}
+
+// TraceRay path-traces a ray through world, recursing through each hit
+// surface's Material.Scatter and multiplying up the attenuation along the
+// way, until it escapes into background or hits the bounce limit. Each
+// hit surface's own emission is added in on top, so emissive materials
+// (e.g. DiffuseLight) act as light sources without needing a background.
+func TraceRay(ray Ray, world Hittable, background Background, depth, maxDepth int) Vec3 {
+	if depth >= maxDepth {
+		return Vec3{0, 0, 0}
+	}
+
+	rec, hit := world.Hit(ray, 0.001, math.Inf(1))
+	if !hit {
+		return background(ray)
+	}
+
+	emitted := rec.Material.Emitted()
+
+	attenuation, scattered, ok := rec.Material.Scatter(ray, rec)
+	if !ok {
+		return emitted
+	}
+
+	return emitted.Add(attenuation.MulVec(TraceRay(scattered, world, background, depth+1, maxDepth)))
+}
+
+// RenderPixel accumulates one jittered sample per cell of a stratified
+// ceil(sqrt(samples))^2 grid covering pixel (x, y), then averages and
+// gamma-corrects the result. samples is a lower bound on the sample
+// count, not an exact one: every cell in the grid is sampled so coverage
+// stays even, which rounds samples up to the next perfect square.
+func RenderPixel(x, y, width, height int, camera *Camera, world Hittable, background Background, maxDepth, samples int) Vec3 {
+	gridSize := int(math.Ceil(math.Sqrt(float64(samples))))
+	cellCount := gridSize * gridSize
+
+	color := Vec3{}
+	for i := 0; i < cellCount; i++ {
+		cellX := i % gridSize
+		cellY := i / gridSize
+		px := float64(x) + (float64(cellX)+rand.Float64())/float64(gridSize)
+		py := float64(y) + (float64(cellY)+rand.Float64())/float64(gridSize)
+
+		s := px / float64(width)
+		t := 1 - py/float64(height)
+		color = color.Add(TraceRay(camera.GetRay(s, t), world, background, 0, maxDepth))
+	}
+
+	return GammaCorrect(color.Mul(1.0 / float64(cellCount)))
+}
+
+// GammaCorrect applies a gamma-2 transform (sqrt of the linear color) so
+// that averaged path-traced output matches sRGB display expectations.
+func GammaCorrect(c Vec3) Vec3 {
+	return Vec3{
+		math.Sqrt(math.Max(0, c.X)),
+		math.Sqrt(math.Max(0, c.Y)),
+		math.Sqrt(math.Max(0, c.Z)),
+	}
+}
@@ -0,0 +1,65 @@
+package tracer
+
+import "math"
+
+// Triangle is a single mesh face with per-vertex normals, interpolated
+// across the surface at hit time for smooth shading.
+type Triangle struct {
+	V0, V1, V2 Vec3
+	N0, N1, N2 Vec3
+	Material   Material
+}
+
+const triangleEpsilon = 1e-8
+
+// Hit implements the Möller–Trumbore ray-triangle intersection algorithm.
+func (t *Triangle) Hit(ray Ray, tMin, tMax float64) (HitRecord, bool) {
+	edge1 := t.V1.Sub(t.V0)
+	edge2 := t.V2.Sub(t.V0)
+	pvec := ray.Direction.Cross(edge2)
+	det := edge1.Dot(pvec)
+
+	if math.Abs(det) < triangleEpsilon {
+		return HitRecord{}, false
+	}
+	invDet := 1.0 / det
+
+	tvec := ray.Origin.Sub(t.V0)
+	u := tvec.Dot(pvec) * invDet
+	if u < 0 || u > 1 {
+		return HitRecord{}, false
+	}
+
+	qvec := tvec.Cross(edge1)
+	v := ray.Direction.Dot(qvec) * invDet
+	if v < 0 || u+v > 1 {
+		return HitRecord{}, false
+	}
+
+	hitT := edge2.Dot(qvec) * invDet
+	if hitT < tMin || hitT > tMax {
+		return HitRecord{}, false
+	}
+
+	w := 1 - u - v
+	outwardNormal := t.N0.Mul(w).Add(t.N1.Mul(u)).Add(t.N2.Mul(v)).Normalize()
+	frontFace := ray.Direction.Dot(outwardNormal) < 0
+	normal := outwardNormal
+	if !frontFace {
+		normal = outwardNormal.Mul(-1)
+	}
+
+	return HitRecord{
+		T:         hitT,
+		Point:     ray.At(hitT),
+		Normal:    normal,
+		FrontFace: frontFace,
+		Material:  t.Material,
+	}, true
+}
+
+func (t *Triangle) BoundingBox() (AABB, bool) {
+	min := t.V0.Min(t.V1).Min(t.V2)
+	max := t.V0.Max(t.V1).Max(t.V2)
+	return AABB{Min: min, Max: max}, true
+}
@@ -0,0 +1,65 @@
+package tracer
+
+import "math"
+
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+func (v Vec3) Add(other Vec3) Vec3 {
+	return Vec3{v.X + other.X, v.Y + other.Y, v.Z + other.Z}
+}
+
+func (v Vec3) Sub(other Vec3) Vec3 {
+	return Vec3{v.X - other.X, v.Y - other.Y, v.Z - other.Z}
+}
+
+func (v Vec3) Mul(scalar float64) Vec3 {
+	return Vec3{v.X * scalar, v.Y * scalar, v.Z * scalar}
+}
+
+func (v Vec3) MulVec(other Vec3) Vec3 {
+	return Vec3{v.X * other.X, v.Y * other.Y, v.Z * other.Z}
+}
+
+func (v Vec3) Dot(other Vec3) float64 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+}
+
+func (v Vec3) Cross(other Vec3) Vec3 {
+	return Vec3{
+		v.Y*other.Z - v.Z*other.Y,
+		v.Z*other.X - v.X*other.Z,
+		v.X*other.Y - v.Y*other.X,
+	}
+}
+
+func (v Vec3) Normalize() Vec3 {
+	length := math.Sqrt(v.Dot(v))
+	return Vec3{v.X / length, v.Y / length, v.Z / length}
+}
+
+func (v Vec3) Length() float64 {
+	return math.Sqrt(v.Dot(v))
+}
+
+// Min returns the component-wise minimum of v and other.
+func (v Vec3) Min(other Vec3) Vec3 {
+	return Vec3{math.Min(v.X, other.X), math.Min(v.Y, other.Y), math.Min(v.Z, other.Z)}
+}
+
+// Max returns the component-wise maximum of v and other.
+func (v Vec3) Max(other Vec3) Vec3 {
+	return Vec3{math.Max(v.X, other.X), math.Max(v.Y, other.Y), math.Max(v.Z, other.Z)}
+}
+
+func (v Vec3) Component(axis int) float64 {
+	switch axis {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	default:
+		return v.Z
+	}
+}